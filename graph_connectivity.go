@@ -8,10 +8,13 @@ import (
 	"os"
 )
 
-// Graph представляет неориентированный граф с использованием списка смежности
+// Graph представляет граф с использованием списка смежности.
+// По умолчанию граф считается неориентированным; если IsDirected == true,
+// AdjList хранит только рёбра "из u в v" и не дублируется в обратную сторону
 type Graph struct {
-	Vertices int     // Количество вершин в графе
-	AdjList  [][]int // Список смежности, где AdjList[i] содержит список вершин, смежных с вершиной i
+	Vertices   int     // Количество вершин в графе
+	AdjList    [][]int // Список смежности, где AdjList[i] содержит список вершин, смежных с вершиной i
+	IsDirected bool    // Признак того, что граф ориентированный
 }
 
 // NewGraph создаёт новый граф с заданным числом вершин
@@ -32,6 +35,37 @@ func (g *Graph) AddEdge(u, v int) {
 	g.AdjList[v] = append(g.AdjList[v], u) // Добавляем u в список смежности v (так как граф неориентированный)
 }
 
+// AddDirectedEdge добавляет ориентированное ребро из u в v (только в одну сторону)
+func (g *Graph) AddDirectedEdge(u, v int) {
+	g.AdjList[u] = append(g.AdjList[u], v) // Добавляем v в список смежности u
+}
+
+// undirectedShadow строит неориентированную "тень" графа: для ориентированного
+// графа каждое ребро u->v превращается в неориентированное ребро (u, v), без
+// дублирования, если в обе стороны уже существовали оба направления.
+// Алгоритмы связности (точки сочленения, мосты, компоненты двусвязности,
+// компоненты связности, остовный лес, блочно-срезанное дерево) по своей
+// природе неориентированные, поэтому для ориентированного графа они должны
+// работать именно с этой тенью, а не с односторонним AdjList
+func (g *Graph) undirectedShadow() *Graph {
+	h := NewGraph(g.Vertices)
+	seen := map[[2]int]bool{}
+	for u := 0; u < g.Vertices; u++ {
+		for _, v := range g.AdjList[u] {
+			key := [2]int{u, v}
+			if u > v {
+				key = [2]int{v, u}
+			}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			h.AddEdge(u, v)
+		}
+	}
+	return h
+}
+
 // ReadGraph считывает граф из бинарного файла
 // Формат файла: int16 размер, затем матрица смежности размер x размер, элементы типа int16
 func ReadGraph(filename string) (*Graph, error) {
@@ -66,11 +100,29 @@ func ReadGraph(filename string) (*Graph, error) {
 		}
 	}
 
+	// Определяем, является ли матрица смежности симметричной: если хотя бы одна
+	// пара (i, j) не совпадает с (j, i), граф считается ориентированным
+	directed := false
+	for i := 0; i < int(size) && !directed; i++ {
+		for j := 0; j < int(size); j++ {
+			if adjMatrix[i][j] != adjMatrix[j][i] {
+				directed = true
+				break
+			}
+		}
+	}
+
 	// Преобразуем матрицу смежности в список смежности для удобства обработки
 	graph := NewGraph(int(size))
+	graph.IsDirected = directed
 	for i := 0; i < int(size); i++ {
 		for j := 0; j < int(size); j++ {
-			if adjMatrix[i][j] != 0 && i < j { // Проверяем наличие ребра и избегаем дублирования (так как граф неориентированный)
+			if adjMatrix[i][j] == 0 {
+				continue
+			}
+			if directed {
+				graph.AddDirectedEdge(i, j) // Ориентированный граф: сохраняем направление ребра как есть
+			} else if i < j { // Неориентированный граф: избегаем дублирования ребра
 				graph.AddEdge(i, j)
 			}
 		}
@@ -79,82 +131,92 @@ func ReadGraph(filename string) (*Graph, error) {
 	return graph, nil
 }
 
-//Алг. Тарьяна
-// ArticulationPointsAndBridges находит точки сочленения и мосты в графе
+// dfsFrame - кадр явного стека итеративного DFS: текущая вершина и индекс
+// следующего непросмотренного соседа в её списке смежности
+type dfsFrame struct {
+	v int
+	i int
+}
+
+// Алг. Тарьяна
+// ArticulationPointsAndBridges находит точки сочленения и мосты в графе.
+// DFS реализован итеративно через явный стек кадров (vertex, adjacencyIndex),
+// а не через рекурсию, чтобы не упираться в лимит глубины стека горутины на
+// графах с сотнями тысяч вершин
 func (g *Graph) ArticulationPointsAndBridges() (articulationPoints []int, bridges [][2]int) {
-	visited := make([]bool, g.Vertices)    // Массив для отслеживания посещённых вершин
-	discovery := make([]int, g.Vertices)   // Время обнаружения каждой вершины
-	low := make([]int, g.Vertices)         // Низшее время, доступное из поддерева вершины
-	parent := make([]int, g.Vertices)      // Родитель каждой вершины в DFS-дереве
+	if g.IsDirected {
+		return g.undirectedShadow().ArticulationPointsAndBridges()
+	}
+
+	visited := make([]bool, g.Vertices)  // Массив для отслеживания посещённых вершин
+	discovery := make([]int, g.Vertices) // Время обнаружения каждой вершины
+	low := make([]int, g.Vertices)       // Низшее время, доступное из поддерева вершины
+	parent := make([]int, g.Vertices)    // Родитель каждой вершины в DFS-дереве
+	children := make([]int, g.Vertices)  // Количество дочерних вершин в DFS-дереве
 	for i := range parent {
 		parent[i] = -1 // Инициализируем родителя как -1 (нет родителя)
 	}
-	time := 0 // Глобальное время для DFS
+	time := 0                // Глобальное время для DFS
 	ap := make(map[int]bool) // Множество точек сочленения
-	br := [][2]int{}          // Список мостов
-
-	// Рекурсивная функция DFS для обхода графа и вычисления low
-	var dfs func(u int)
-	dfs = func(u int) {
-		visited[u] = true                // Отмечаем вершину как посещённую
-		discovery[u] = time              // Устанавливаем время обнаружения вершины u
-		low[u] = time                     // Инициализируем low[u] текущим временем
-		time++                            // Увеличиваем глобальное время
-		children := 0                      // Количество дочерних вершин в DFS-дереве
-
-		for _, v := range g.AdjList[u] { // Проходим по всем смежным вершинам v вершины u
-			if !visited[v] { // Если вершина v ещё не посещена
-				children++            // Увеличиваем счётчик дочерних вершин
-				parent[v] = u         // Устанавливаем u как родителя для v
-				dfs(v)                // Рекурсивно вызываем DFS для вершины v
+	br := [][2]int{}         // Список мостов
 
-				low[u] = min(low[u], low[v]) // Обновляем low[u] как минимум из текущего low[u] и low[v]
+	// Запускаем DFS для всех компонент связности графа
+	for root := 0; root < g.Vertices; root++ {
+		if visited[root] {
+			continue
+		}
 
-				// Проверяем, является ли вершина u точкой сочленения
+		visited[root] = true
+		discovery[root] = time
+		low[root] = time
+		time++
+		stack := []dfsFrame{{root, 0}}
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			u := top.v
+
+			if top.i < len(g.AdjList[u]) {
+				v := g.AdjList[u][top.i]
+				top.i++
+				if !visited[v] { // Если вершина v ещё не посещена - "заходим" в неё
+					visited[v] = true
+					parent[v] = u
+					children[u]++ // Увеличиваем счётчик дочерних вершин
+					discovery[v] = time
+					low[v] = time
+					time++
+					stack = append(stack, dfsFrame{v, 0})
+				} else if v != parent[u] { // Обратное ребро (back edge) от u к v
+					low[u] = min(low[u], discovery[v])
+				}
+			} else {
+				// Все соседи u просмотрены - "выходим" из u обратно к её родителю
+				stack = stack[:len(stack)-1]
+				v := u
+				u = parent[v]
+				if u == -1 {
+					continue // v была корнем DFS-дерева, возвращаться некуда
+				}
+
+				low[u] = min(low[u], low[v]) // Обновляем low[u] как минимум из текущего low[u] и low[v]
 
 				// Условие 1: Если u - корень DFS и имеет более одного дочернего поддерева
-				if parent[u] == -1 && children > 1 {
+				if parent[u] == -1 && children[u] > 1 {
 					ap[u] = true // Вершина u является точкой сочленения
 				}
-				//Если у корня DFS дерева более одного дочернего поддерева, это означает, 
-				//что существует более одной подгруппы вершин, которые связаны через корень, но не связаны между собой напрямую.
-				//То есть, все эти поддеревья соединены только через корень.
-
 				// Условие 2: Если u не корень и low[v] >= discovery[u]
 				if parent[u] != -1 && low[v] >= discovery[u] {
 					ap[u] = true // Вершина u является точкой сочленения
 				}
-				
-				// Точки сочленения: Вершина u является точкой сочленения, если существует хотя бы один потомок v в дереве DFS такой, 
-				// что low[v] >= discovery[u]. Это означает, что нет обратного пути из поддерева v, который мог бы вернуться к предкам u, кроме самого u. 
-				// Удаление u разрывает граф на отдельные компоненты.
-
+				// Мост: ребро (u, v) является мостом, если low[v] > discovery[u]
 				if low[v] > discovery[u] {
-					br = append(br, [2]int{u, v}) // Ребро (u, v) добавляется в список мостов
+					br = append(br, [2]int{u, v})
 				}
-				
-				// Мосты: Ребро (u, v) является мостом, если low[v] > discovery[u]. 
-				// Это означает, что нет других путей из поддерева v, которые могли бы соединиться с предками u, кроме через ребро (u, v). 
-				// Удаление такого ребра увеличивает количество компонент связности.
-				
-			} else if v != parent[u] { // Если вершина v уже посещена и не является родителем u
-				// Обновляем low[u] как минимум из текущего low[u] и discovery[v]
-				// Это учитывает обратное ребро (back edge) от u к v
-				low[u] = min(low[u], discovery[v])
-				//Уменьшение low[u] происходит:
-				//Через обратные рёбра: Когда из вершины u существует обратный путь к более ранней вершине v.
-				//Через дочерние вершины: Если из поддерева дочерней вершины v существует путь к более ранней вершине, чем текущая вершина u.
 			}
 		}
 	}
 
-	// Запускаем DFS для всех компонент связности графа
-	for u := 0; u < g.Vertices; u++ {
-		if !visited[u] {
-			dfs(u) // Запускаем DFS для непосещённой вершины u
-		}
-	}
-
 	// Собираем все точки сочленения из карты в срез
 	for k := range ap {
 		articulationPoints = append(articulationPoints, k)
@@ -163,111 +225,586 @@ func (g *Graph) ArticulationPointsAndBridges() (articulationPoints []int, bridge
 	return articulationPoints, br // Возвращаем список точек сочленения и мостов
 }
 
-//Алг. Тарьяна
+// Алг. Тарьяна
 // BiconnectedComponents находит компоненты двусвязности графа
 func (g *Graph) BiconnectedComponents() [][][2]int {
-	visited := make([]bool, g.Vertices)    // Массив для отслеживания посещённых вершин
-	discovery := make([]int, g.Vertices)   // Время обнаружения каждой вершины
-	low := make([]int, g.Vertices)         // Низшее время, доступное из поддерева вершины
-	parent := make([]int, g.Vertices)      // Родитель каждой вершины в DFS-дереве
+	if g.IsDirected {
+		return g.undirectedShadow().BiconnectedComponents()
+	}
+
+	visited := make([]bool, g.Vertices)  // Массив для отслеживания посещённых вершин
+	discovery := make([]int, g.Vertices) // Время обнаружения каждой вершины
+	low := make([]int, g.Vertices)       // Низшее время, доступное из поддерева вершины
+	parent := make([]int, g.Vertices)    // Родитель каждой вершины в DFS-дереве
 	for i := range parent {
 		parent[i] = -1 // Инициализируем родителя как -1 (нет родителя)
 	}
-	time := 0                        // Глобальное время для DFS
-	stack := [][2]int{}              // Стек для хранения рёбер текущей компоненты
-	bcc := [][][2]int{}              // Список всех компонент двусвязности
-
-	// Рекурсивная функция DFS для обхода графа и вычисления low
-	var dfs func(u int)
-	dfs = func(u int) {
-		visited[u] = true                // Отмечаем вершину как посещённую
-		discovery[u] = time              // Устанавливаем время обнаружения вершины u
-		low[u] = time                     // Инициализируем low[u] текущим временем
-		time++                            // Увеличиваем глобальное время
+	time := 0               // Глобальное время для DFS
+	edgeStack := [][2]int{} // Стек для хранения рёбер текущей компоненты
+	bcc := [][][2]int{}     // Список всех компонент двусвязности
+
+	// Запускаем DFS для всех компонент связности графа. DFS реализован итеративно
+	// через явный стек кадров (vertex, adjacencyIndex), чтобы не упираться в лимит
+	// глубины стека горутины на графах с сотнями тысяч вершин
+	for root := 0; root < g.Vertices; root++ {
+		if visited[root] {
+			continue
+		}
 
-		for _, v := range g.AdjList[u] { // Проходим по всем смежным вершинам v вершины u
-			if !visited[v] { // Если вершина v ещё не посещена
-				parent[v] = u         // Устанавливаем u как родителя для v
-				stack = append(stack, [2]int{u, v}) // Добавляем ребро (u, v) в стек
-				dfs(v)                // Рекурсивно вызываем DFS для вершины v
+		visited[root] = true
+		discovery[root] = time
+		low[root] = time
+		time++
+		stack := []dfsFrame{{root, 0}}
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			u := top.v
+
+			if top.i < len(g.AdjList[u]) {
+				v := g.AdjList[u][top.i]
+				top.i++
+				if !visited[v] { // Если вершина v ещё не посещена - "заходим" в неё
+					parent[v] = u
+					edgeStack = append(edgeStack, [2]int{u, v}) // Добавляем ребро (u, v) в стек
+					visited[v] = true
+					discovery[v] = time
+					low[v] = time
+					time++
+					stack = append(stack, dfsFrame{v, 0})
+				} else if v != parent[u] && discovery[v] < discovery[u] { // Обратное ребро к более ранней вершине
+					low[u] = min(low[u], discovery[v])
+					edgeStack = append(edgeStack, [2]int{u, v})
+				}
+			} else {
+				// Все соседи u просмотрены - "выходим" из u обратно к её родителю
+				stack = stack[:len(stack)-1]
+				v := u
+				u = parent[v]
+				if u == -1 {
+					continue // v была корнем DFS-дерева, возвращаться некуда
+				}
 
 				low[u] = min(low[u], low[v]) // Обновляем low[u] как минимум из текущего low[u] и low[v]
 
-				// Проверяем, разделяет ли ребро (u, v) компоненты двусвязности
-					// Этот участок кода отвечает за выделение новой компоненты двусвязности после обнаружения условия, 
-					// при котором текущая вершина u разделяет граф на компоненты двусвязности. 
-					// Основная задача — извлечь все рёбра, принадлежащие этой новой компоненте, 
-					// из стека и сохранить их в списке компонент bcc.
-					//
-					// проверка условия low[v] >= discovery[u] позволяет определить, 
-					// когда текущая вершина u разделяет граф на независимые компоненты
+				// Условие low[v] >= discovery[u] означает, что вершина u разделяет
+				// граф на компоненты двусвязности - извлекаем рёбра текущей
+				// компоненты из стека вплоть до разделяющего ребра (u, v)
 				if low[v] >= discovery[u] {
-					component := [][2]int{} // Создаём новую компоненту двусвязности
+					component := [][2]int{}
 					for {
-						if len(stack) == 0 {
-							break // Если стек пуст, выходим из цикла
+						if len(edgeStack) == 0 {
+							break
 						}
-						edge := stack[len(stack)-1] // Берём последнее ребро из стека
-						stack = stack[:len(stack)-1] // Удаляем это ребро из стека
-						component = append(component, edge) // Добавляем ребро в текущую компоненту
-						if edge[0] == u && edge[1] == v { // Если достигли разделяющего ребра
-							break // Завершаем сбор текущей компоненты
+						edge := edgeStack[len(edgeStack)-1]
+						edgeStack = edgeStack[:len(edgeStack)-1]
+						component = append(component, edge)
+						if edge[0] == u && edge[1] == v {
+							break
 						}
 					}
-					bcc = append(bcc, component) // Добавляем компоненту в список компонент двусвязности
+					bcc = append(bcc, component)
 				}
-			} else if v != parent[u] && discovery[v] < discovery[u] { // Если вершина v уже посещена, не является родителем, и была обнаружена раньше
-				low[u] = min(low[u], discovery[v]) // Обновляем low[u] как минимум из текущего low[u] и discovery[v]
-				stack = append(stack, [2]int{u, v}) // Добавляем ребро (u, v) в стек
-				//Уменьшение low[u] происходит:
-				//Через обратные рёбра: Когда из вершины u существует обратный путь к более ранней вершине v.
-				//Через дочерние вершины: Если из поддерева дочерней вершины v существует путь к более ранней вершине, чем текущая вершина u.
 			}
 		}
+
+		// После завершения DFS, если в стеке остались рёбра, они образуют отдельную компоненту двусвязности
+		if len(edgeStack) > 0 {
+			bcc = append(bcc, edgeStack)
+			edgeStack = [][2]int{}
+		}
 	}
 
-	// Запускаем DFS для всех компонент связности графа
+	return bcc // Возвращаем список компонент двусвязности
+}
+
+// Tree описывает результат построения остовного дерева одной компоненты связности:
+// рёбра дерева (ветви DFS-обхода) отдельно от хорд (остальных рёбер графа)
+type Tree struct {
+	Root   int      // Корень остовного дерева
+	Edges  [][2]int // Ветви - рёбра, вошедшие в дерево DFS-обхода
+	Chords [][2]int // Хорды - рёбра графа, не вошедшие в дерево
+}
+
+// buildSpanningTree строит остовное дерево компоненты связности, содержащей root,
+// используя общий для всего леса массив visited. DFS реализован итеративно через
+// явный стек кадров (vertex, adjacencyIndex), как и остальные обходы в этом файле,
+// чтобы не упираться в лимит глубины стека горутины на графах с сотнями тысяч вершин
+func (g *Graph) buildSpanningTree(root int, visited []bool) Tree {
+	parent := make([]int, g.Vertices) // Родитель каждой вершины в DFS-дереве
+	for i := range parent {
+		parent[i] = -1 // Инициализируем родителя как -1 (нет родителя)
+	}
+	edges := [][2]int{}            // Ветви дерева
+	chords := [][2]int{}           // Хорды дерева
+	seenChord := map[[2]int]bool{} // Хорды неориентированного графа встречаются с обеих сторон, отбрасываем дубликаты
+
+	visited[root] = true
+	stack := []dfsFrame{{root, 0}}
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		u := top.v
+
+		if top.i >= len(g.AdjList[u]) {
+			stack = stack[:len(stack)-1] // Все соседи u просмотрены - выходим из u
+			continue
+		}
+
+		v := g.AdjList[u][top.i]
+		top.i++
+		if !visited[v] { // Ребро (u, v) ведёт в ещё не посещённую вершину - это ветвь дерева
+			visited[v] = true
+			parent[v] = u
+			edges = append(edges, [2]int{u, v})
+			stack = append(stack, dfsFrame{v, 0})
+		} else if v != parent[u] { // Ребро (u, v) ведёт в уже посещённую вершину (и не является родительским) - это хорда
+			key := [2]int{u, v}
+			if u > v {
+				key = [2]int{v, u} // Нормализуем пару, чтобы не добавить одну и ту же хорду дважды
+			}
+			if !seenChord[key] {
+				seenChord[key] = true
+				chords = append(chords, key)
+			}
+		}
+	}
+
+	return Tree{Root: root, Edges: edges, Chords: chords}
+}
+
+// SpanningTree строит остовное дерево компоненты связности, содержащей вершину root
+func (g *Graph) SpanningTree(root int) Tree {
+	if g.IsDirected {
+		return g.undirectedShadow().SpanningTree(root)
+	}
+
+	visited := make([]bool, g.Vertices) // Массив для отслеживания посещённых вершин
+	return g.buildSpanningTree(root, visited)
+}
+
+// SpanningForest строит остовный лес - по одному остовному дереву на каждую компоненту связности графа
+func (g *Graph) SpanningForest() []Tree {
+	if g.IsDirected {
+		return g.undirectedShadow().SpanningForest()
+	}
+
+	visited := make([]bool, g.Vertices) // Массив для отслеживания посещённых вершин
+	forest := []Tree{}                  // Список остовных деревьев
+
+	// Запускаем построение дерева для всех компонент связности графа
 	for u := 0; u < g.Vertices; u++ {
 		if !visited[u] {
-			dfs(u) // Запускаем DFS для непосещённой вершины u
-			// После завершения DFS, если в стеке остались рёбра, они образуют отдельную компоненту двусвязности
-			if len(stack) > 0 {
-				bcc = append(bcc, stack) // Добавляем оставшиеся рёбра как отдельную компоненту
-				stack = [][2]int{}        // Очищаем стек для следующей компоненты
-			}
+			forest = append(forest, g.buildSpanningTree(u, visited)) // Строим дерево для непосещённой вершины u
 		}
 	}
 
-	return bcc // Возвращаем список компонент двусвязности
+	return forest
 }
 
 // ConnectedComponents находит компоненты связности графа
 func (g *Graph) ConnectedComponents() [][]int {
+	if g.IsDirected {
+		return g.undirectedShadow().ConnectedComponents()
+	}
+
 	visited := make([]bool, g.Vertices) // Массив для отслеживания посещённых вершин
-	components := [][]int{}              // Список всех компонент связности
-
-	// Рекурсивная функция DFS для поиска компонент связности
-	var dfs func(u int, component *[]int)
-	dfs = func(u int, component *[]int) {
-		visited[u] = true                    // Отмечаем вершину как посещённую
-		*component = append(*component, u)   // Добавляем вершину в текущую компоненту
-		for _, v := range g.AdjList[u] {    // Проходим по всем смежным вершинам v вершины u
-			if !visited[v] {
-				dfs(v, component) // Рекурсивно вызываем DFS для непосещённой вершины v
+	components := [][]int{}             // Список всех компонент связности
+
+	// Запускаем DFS для всех компонент связности графа. DFS реализован итеративно
+	// через явный стек кадров (vertex, adjacencyIndex) вместо рекурсии, чтобы не
+	// упираться в лимит глубины стека горутины на графах с сотнями тысяч вершин
+	for root := 0; root < g.Vertices; root++ {
+		if visited[root] {
+			continue
+		}
+
+		visited[root] = true
+		component := []int{root} // Создаём новую компоненту связности
+		stack := []dfsFrame{{root, 0}}
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			u := top.v
+			if top.i < len(g.AdjList[u]) {
+				v := g.AdjList[u][top.i]
+				top.i++
+				if !visited[v] {
+					visited[v] = true
+					component = append(component, v) // Добавляем вершину в текущую компоненту
+					stack = append(stack, dfsFrame{v, 0})
+				}
+			} else {
+				stack = stack[:len(stack)-1] // Все соседи u просмотрены - выходим из u
 			}
 		}
+
+		components = append(components, component) // Добавляем компоненту в список
 	}
 
-	// Запускаем DFS для всех компонент связности графа
+	return components // Возвращаем список компонент связности
+}
+
+// Алг. Тарьяна
+// StronglyConnectedComponents находит компоненты сильной связности ориентированного графа
+func (g *Graph) StronglyConnectedComponents() [][]int {
+	index := make([]int, g.Vertices)    // Время обнаружения (индекс) каждой вершины, -1 значит "не посещена"
+	lowlink := make([]int, g.Vertices)  // Наименьший индекс, достижимый из поддерева вершины
+	onStack := make([]bool, g.Vertices) // Признак того, что вершина сейчас находится в стеке
+	for i := range index {
+		index[i] = -1
+	}
+	time := 0         // Глобальный счётчик индекса для DFS
+	stack := []int{}  // Явный стек вершин текущей "ветки" компонент
+	sccs := [][]int{} // Список найденных компонент сильной связности
+
+	// Рекурсивная функция DFS, реализующая алгоритм Тарьяна
+	var dfs func(v int)
+	dfs = func(v int) {
+		index[v] = time   // Присваиваем вершине v текущее время обнаружения
+		lowlink[v] = time // Изначально lowlink[v] равен её собственному индексу
+		time++
+		stack = append(stack, v) // Кладём v в стек
+		onStack[v] = true        // Отмечаем, что v находится в стеке
+
+		for _, w := range g.AdjList[v] { // Проходим по всем соседям w вершины v
+			if index[w] == -1 { // Если w ещё не посещена, рекурсивно обходим её
+				dfs(w)
+				lowlink[v] = min(lowlink[v], lowlink[w]) // Поднимаем lowlink[v], если поддерево w достаёт выше
+			} else if onStack[w] { // Если w уже в стеке, значит найдено обратное ребро внутри текущей компоненты
+				lowlink[v] = min(lowlink[v], index[w])
+			}
+		}
+
+		// Если v - корень компоненты сильной связности (lowlink не поднялся выше её индекса),
+		// извлекаем из стека все вершины вплоть до v - это и есть одна SCC
+		if lowlink[v] == index[v] {
+			component := []int{}
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == v {
+					break
+				}
+			}
+			sccs = append(sccs, component)
+		}
+	}
+
+	// Запускаем DFS для всех непосещённых вершин графа
+	for v := 0; v < g.Vertices; v++ {
+		if index[v] == -1 {
+			dfs(v)
+		}
+	}
+
+	return sccs
+}
+
+// CondensationDAG строит конденсацию графа: каждая компонента сильной связности
+// стягивается в одну вершину результирующего ориентированного ациклического графа (DAG)
+func (g *Graph) CondensationDAG() *Graph {
+	sccs := g.StronglyConnectedComponents()
+
+	// component[v] - номер компоненты, в которую попала вершина v
+	component := make([]int, g.Vertices)
+	for i, scc := range sccs {
+		for _, v := range scc {
+			component[v] = i
+		}
+	}
+
+	dag := NewGraph(len(sccs))
+	dag.IsDirected = true
+
+	// Чтобы не дублировать рёбра между одной и той же парой компонент
+	seen := make(map[[2]int]bool)
 	for u := 0; u < g.Vertices; u++ {
-		if !visited[u] {
-			component := []int{}   // Создаём новую компоненту связности
-			dfs(u, &component)     // Запускаем DFS для вершины u
-			components = append(components, component) // Добавляем компоненту в список
+		for _, v := range g.AdjList[u] {
+			cu, cv := component[u], component[v]
+			if cu == cv {
+				continue // Ребро внутри одной компоненты не попадает в конденсацию
+			}
+			key := [2]int{cu, cv}
+			if !seen[key] {
+				seen[key] = true
+				dag.AddDirectedEdge(cu, cv)
+			}
 		}
 	}
 
-	return components // Возвращаем список компонент связности
+	return dag
+}
+
+// LCA хранит результат предвычислений для ответа на запросы наименьшего общего предка (LCA)
+// в дереве за O(log n) с помощью двоичного подъёма (binary lifting)
+type LCA struct {
+	depth []int   // depth[v] - глубина вершины v относительно корня
+	up    [][]int // up[k][v] - предок вершины v на расстоянии 2^k
+	logN  int     // Максимальная степень двойки, использованная в таблице подъёмов
+}
+
+// BuildLCA выполняет DFS от вершины root и строит таблицу двоичного подъёма,
+// необходимую для последующих запросов Query и Distance
+func (g *Graph) BuildLCA(root int) *LCA {
+	logN := 1
+	for (1 << logN) < g.Vertices {
+		logN++
+	}
+	logN++ // Берём с запасом: ceil(log2(n)) + 1
+
+	depth := make([]int, g.Vertices)
+	up := make([][]int, logN+1)
+	for k := range up {
+		up[k] = make([]int, g.Vertices)
+		for v := range up[k] {
+			up[k][v] = -1 // -1 означает отсутствие предка (вершина выше корня)
+		}
+	}
+	visited := make([]bool, g.Vertices)
+
+	// DFS, вычисляющий глубину каждой вершины и её непосредственного родителя (up[0])
+	var dfs func(u, parent int)
+	dfs = func(u, parent int) {
+		visited[u] = true
+		up[0][u] = parent
+		for _, v := range g.AdjList[u] {
+			if !visited[v] {
+				depth[v] = depth[u] + 1
+				dfs(v, u)
+			}
+		}
+	}
+	dfs(root, -1)
+
+	// Достраиваем таблицу подъёмов: up[k][v] = up[k-1][up[k-1][v]]
+	for k := 1; k <= logN; k++ {
+		for v := 0; v < g.Vertices; v++ {
+			if up[k-1][v] == -1 {
+				up[k][v] = -1
+			} else {
+				up[k][v] = up[k-1][up[k-1][v]]
+			}
+		}
+	}
+
+	return &LCA{depth: depth, up: up, logN: logN}
+}
+
+// Query возвращает наименьшего общего предка вершин u и v за O(log n)
+func (l *LCA) Query(u, v int) int {
+	if l.depth[u] < l.depth[v] {
+		u, v = v, u // Убеждаемся, что u не мельче v
+	}
+
+	// Поднимаем u на разницу глубин, чтобы обе вершины оказались на одном уровне
+	diff := l.depth[u] - l.depth[v]
+	for k := 0; diff > 0; k++ {
+		if diff&1 == 1 {
+			u = l.up[k][u]
+		}
+		diff >>= 1
+	}
+
+	if u == v {
+		return u // v уже является предком u
+	}
+
+	// Поднимаем обе вершины одновременно, пока их предки не совпадут
+	for k := l.logN; k >= 0; k-- {
+		if l.up[k][u] != l.up[k][v] {
+			u = l.up[k][u]
+			v = l.up[k][v]
+		}
+	}
+
+	return l.up[0][u] // Общий непосредственный родитель - искомый LCA
+}
+
+// Distance возвращает расстояние между вершинами u и v в дереве через их LCA
+func (l *LCA) Distance(u, v int) int {
+	anc := l.Query(u, v)
+	return l.depth[u] + l.depth[v] - 2*l.depth[anc]
+}
+
+// BCNodeKind различает два типа узлов блочно-срезанного дерева
+type BCNodeKind int
+
+const (
+	Cut   BCNodeKind = iota // Узел - точка сочленения
+	Block                   // Узел - компонента двусвязности (блок)
+)
+
+// BCNode - узел блочно-срезанного дерева: либо точка сочленения (Vertex),
+// либо блок (Edges - рёбра компоненты двусвязности, которую он представляет)
+type BCNode struct {
+	Kind   BCNodeKind
+	Vertex int      // Номер вершины, если Kind == Cut
+	Edges  [][2]int // Рёбра блока, если Kind == Block
+}
+
+// BlockCutTree - блочно-срезанное дерево графа: двудольное дерево, в котором
+// вершины одного типа - точки сочленения, а другого - блоки (компоненты
+// двусвязности), и ребро соединяет точку сочленения с каждым блоком, её содержащим
+type BlockCutTree struct {
+	Nodes []BCNode
+	Adj   [][]int
+}
+
+// BlockCutTree строит блочно-срезанное дерево графа g на основе его точек
+// сочленения и компонент двусвязности
+func (g *Graph) BlockCutTree() *BlockCutTree {
+	articulationPoints, _ := g.ArticulationPointsAndBridges()
+	bcc := g.BiconnectedComponents()
+
+	isCut := make(map[int]bool, len(articulationPoints))
+	for _, v := range articulationPoints {
+		isCut[v] = true
+	}
+
+	nodes := []BCNode{}
+
+	// Сначала заводим узел для каждой точки сочленения
+	cutNodeIndex := make(map[int]int, len(articulationPoints))
+	for _, v := range articulationPoints {
+		cutNodeIndex[v] = len(nodes)
+		nodes = append(nodes, BCNode{Kind: Cut, Vertex: v})
+	}
+
+	// Затем - узел для каждого блока
+	blockNodeIndex := make([]int, len(bcc))
+	for i, block := range bcc {
+		blockNodeIndex[i] = len(nodes)
+		nodes = append(nodes, BCNode{Kind: Block, Edges: block})
+	}
+
+	// Соединяем каждый блок с точками сочленения, которые он содержит
+	adj := make([][]int, len(nodes))
+	for i, block := range bcc {
+		blockIdx := blockNodeIndex[i]
+		linked := map[int]bool{} // Чтобы не продублировать ребро, если вершина встречается в блоке несколько раз
+		for _, edge := range block {
+			for _, v := range edge {
+				if !isCut[v] || linked[v] {
+					continue
+				}
+				linked[v] = true
+				cutIdx := cutNodeIndex[v]
+				adj[blockIdx] = append(adj[blockIdx], cutIdx)
+				adj[cutIdx] = append(adj[cutIdx], blockIdx)
+			}
+		}
+	}
+
+	return &BlockCutTree{Nodes: nodes, Adj: adj}
+}
+
+// blockNodesOf возвращает индексы узлов-блоков блочно-срезанного дерева, содержащих вершину v
+func (t *BlockCutTree) blockNodesOf(v int) []int {
+	nodesIdx := []int{}
+	for i, node := range t.Nodes {
+		if node.Kind != Block {
+			continue
+		}
+		for _, edge := range node.Edges {
+			if edge[0] == v || edge[1] == v {
+				nodesIdx = append(nodesIdx, i)
+				break
+			}
+		}
+	}
+	return nodesIdx
+}
+
+// IsSameBlock сообщает, существует ли блок (компонента двусвязности), содержащий обе вершины u и v
+func (t *BlockCutTree) IsSameBlock(u, v int) bool {
+	if u == v {
+		return true
+	}
+	uBlocks := t.blockNodesOf(u)
+	vBlocks := map[int]bool{}
+	for _, idx := range t.blockNodesOf(v) {
+		vBlocks[idx] = true
+	}
+	for _, idx := range uBlocks {
+		if vBlocks[idx] {
+			return true
+		}
+	}
+	return false
+}
+
+// PathBlocks возвращает последовательность узлов-блоков блочно-срезанного дерева,
+// которые должен пересечь любой путь из u в v, в порядке следования от u к v
+func (t *BlockCutTree) PathBlocks(u, v int) []int {
+	if u == v {
+		return nil
+	}
+
+	startSet := map[int]bool{}
+	for _, idx := range t.blockNodesOf(u) {
+		startSet[idx] = true
+	}
+	targetSet := map[int]bool{}
+	for _, idx := range t.blockNodesOf(v) {
+		targetSet[idx] = true
+	}
+	if len(startSet) == 0 || len(targetSet) == 0 {
+		return nil
+	}
+	for idx := range startSet {
+		if targetSet[idx] { // u и v лежат в одном и том же блоке
+			return []int{idx}
+		}
+	}
+
+	// BFS по блочно-срезанному дереву от всех блоков, содержащих u, до первого
+	// встреченного блока, содержащего v
+	visited := make([]bool, len(t.Nodes))
+	parent := make([]int, len(t.Nodes))
+	for i := range parent {
+		parent[i] = -1
+	}
+	queue := []int{}
+	for idx := range startSet {
+		visited[idx] = true
+		queue = append(queue, idx)
+	}
+
+	found := -1
+	for len(queue) > 0 && found == -1 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, next := range t.Adj[cur] {
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			parent[next] = cur
+			if targetSet[next] {
+				found = next
+				break
+			}
+			queue = append(queue, next)
+		}
+	}
+	if found == -1 {
+		return nil
+	}
+
+	path := []int{}
+	for n := found; n != -1; n = parent[n] {
+		if t.Nodes[n].Kind == Block {
+			path = append(path, n)
+		}
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 { // Разворачиваем путь от u к v
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
 }
 
 // min возвращает минимальное из двух целых чисел
@@ -280,19 +817,27 @@ func min(a, b int) int {
 
 func main() {
 	// Определение и разбор командных флагов
-	outputFile := flag.String("o", "output.txt", "Имя выходного файла") // Флаг -o для задания имени выходного файла
-	flag.Parse() // Разбираем флаги
+	outputFile := flag.String("o", "output.txt", "Имя выходного файла")                                  // Флаг -o для задания имени выходного файла
+	inputFormat := flag.String("f", "bin", "Формат входного файла: bin, dimacs, edgelist, adj, graphml") // Флаг -f для задания формата входного файла
+	outputFormat := flag.String("format", "text", "Формат выходного файла: text, dot, json")             // Флаг -format для задания формата выходного файла
+	flag.Parse()                                                                                         // Разбираем флаги
 
 	// Проверяем наличие обязательного аргумента (имени входного файла)
 	if flag.NArg() < 1 {
-		log.Fatalf("Использование: %s inputfile [-o outputfile]", os.Args[0])
+		log.Fatalf("Использование: %s inputfile [-o outputfile] [-f inputformat] [-format outputformat]", os.Args[0])
 	}
 
 	inputFile := flag.Arg(0) // Получаем имя входного файла из аргументов
 	outFile := *outputFile   // Имя выходного файла (либо по умолчанию, либо задано через флаг)
 
-	// Считываем граф из бинарного файла
-	graph, err := ReadGraph(inputFile)
+	// Выбираем читателя графа по формату входного файла (по умолчанию - бинарная матрица смежности)
+	reader, err := NewReader(*inputFormat)
+	if err != nil {
+		log.Fatalf("Не удалось выбрать формат входного файла: %v", err)
+	}
+
+	// Считываем граф
+	graph, err := reader.Read(inputFile)
 	if err != nil {
 		log.Fatalf("Не удалось считать граф: %v", err)
 	}
@@ -306,83 +851,38 @@ func main() {
 	// Находим компоненты связности
 	cc := graph.ConnectedComponents()
 
-	// Открываем (или создаём) выходной файл для записи результатов
-	f, err := os.Create(outFile)
-	if err != nil {
-		log.Fatalf("Ошибка при создании выходного файла: %v", err)
-	}
-	defer f.Close() // Гарантируем закрытие файла после завершения функции
-
-	// Записываем раздел a) Мосты и точки сочленения
-	_, err = f.WriteString("a) Мосты и точки сочленения:\n")
-	if err != nil {
-		log.Fatalf("Ошибка при записи в выходной файл: %v", err)
+	// Для ориентированных графов дополнительно находим компоненты сильной связности
+	var scc [][]int
+	if graph.IsDirected {
+		scc = graph.StronglyConnectedComponents()
 	}
 
-	// Записываем точки сочленения
-	_, err = f.WriteString("Точки сочленения:\n")
-	if len(articulationPoints) == 0 {
-		_, _ = f.WriteString("Отсутствуют\n")
-	} else {
-		for _, ap := range articulationPoints {
-			_, _ = f.WriteString(fmt.Sprintf("%d ", ap))
-		}
-		_, _ = f.WriteString("\n")
-	}
-
-	// Записываем мосты
-	_, err = f.WriteString("Мосты:\n")
-	if len(bridges) == 0 {
-		_, _ = f.WriteString("Отсутствуют\n")
-	} else {
-		for _, bridge := range bridges {
-			_, _ = f.WriteString(fmt.Sprintf("(%d, %d) ", bridge[0], bridge[1]))
-		}
-		_, _ = f.WriteString("\n")
+	// Строим остовный лес графа
+	forest := graph.SpanningForest()
+
+	// Строим блочно-срезанное дерево графа
+	blockCutTree := graph.BlockCutTree()
+
+	result := &AnalysisResult{
+		Graph:              graph,
+		ArticulationPoints: articulationPoints,
+		Bridges:            bridges,
+		BCC:                bcc,
+		CC:                 cc,
+		SCC:                scc,
+		Forest:             forest,
+		BlockCutTree:       blockCutTree,
 	}
 
-	// Записываем раздел b) Компоненты двусвязности
-	_, err = f.WriteString("\nb) Компоненты двусвязности:\n")
+	// Выбираем писателя результатов по формату выходного файла (по умолчанию - текстовый отчёт)
+	writer, err := NewWriter(*outputFormat)
 	if err != nil {
-		log.Fatalf("Ошибка при записи в выходной файл: %v", err)
-	}
-	for i, component := range bcc {
-		_, err = f.WriteString(fmt.Sprintf("Компонента %d:\n", i+1))
-		if err != nil {
-			log.Fatalf("Ошибка при записи в выходной файл: %v", err)
-		}
-		for _, edge := range component {
-			_, err = f.WriteString(fmt.Sprintf("(%d, %d) ", edge[0], edge[1]))
-			if err != nil {
-				log.Fatalf("Ошибка при записи в выходной файл: %v", err)
-			}
-		}
-		_, err = f.WriteString("\n")
-		if err != nil {
-			log.Fatalf("Ошибка при записи в выходной файл: %v", err)
-		}
+		log.Fatalf("Не удалось выбрать формат выходного файла: %v", err)
 	}
 
-	// Записываем раздел c) Компоненты связности
-	_, err = f.WriteString("\nc) Компоненты связности:\n")
-	if err != nil {
-		log.Fatalf("Ошибка при записи в выходной файл: %v", err)
-	}
-	for i, component := range cc {
-		_, err = f.WriteString(fmt.Sprintf("Компонента %d: ", i+1))
-		if err != nil {
-			log.Fatalf("Ошибка при записи в выходной файл: %v", err)
-		}
-		for _, vertex := range component {
-			_, err = f.WriteString(fmt.Sprintf("%d ", vertex))
-			if err != nil {
-				log.Fatalf("Ошибка при записи в выходной файл: %v", err)
-			}
-		}
-		_, err = f.WriteString("\n")
-		if err != nil {
-			log.Fatalf("Ошибка при записи в выходной файл: %v", err)
-		}
+	// Записываем результаты анализа в выходной файл
+	if err := writer.Write(outFile, result); err != nil {
+		log.Fatalf("Ошибка при записи результатов: %v", err)
 	}
 
 	// Выводим сообщение об успешном завершении
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// sortedSCCs приводит результат StronglyConnectedComponents к каноническому виду
+// для сравнения в тестах: вершины внутри компоненты и сами компоненты отсортированы
+func sortedSCCs(sccs [][]int) [][]int {
+	out := make([][]int, len(sccs))
+	for i, scc := range sccs {
+		cp := append([]int{}, scc...)
+		sort.Ints(cp)
+		out[i] = cp
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i][0] < out[j][0] })
+	return out
+}
+
+// newTwoTrianglesWithTailGraph строит граф из двух треугольников, соединённых
+// общей вершиной 2 (точка сочленения), и "хвоста" - моста 4-5:
+//
+//	0---1       3
+//	 \ /       /|
+//	  2-------4 |
+//	           \5 (мост)
+func newTwoTrianglesWithTailGraph() *Graph {
+	g := NewGraph(6)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 2)
+	g.AddEdge(4, 5)
+	return g
+}
+
+func TestBlockCutTree(t *testing.T) {
+	g := newTwoTrianglesWithTailGraph()
+	tree := g.BlockCutTree()
+
+	var cutVertices []int
+	blockCount := 0
+	for _, node := range tree.Nodes {
+		switch node.Kind {
+		case Cut:
+			cutVertices = append(cutVertices, node.Vertex)
+		case Block:
+			blockCount++
+		}
+	}
+	sort.Ints(cutVertices)
+	if !reflect.DeepEqual(cutVertices, []int{2, 4}) {
+		t.Errorf("cut vertices = %v, want [2 4]", cutVertices)
+	}
+	if blockCount != 3 {
+		t.Errorf("block count = %d, want 3 (two triangles + bridge)", blockCount)
+	}
+}
+
+func TestIsSameBlock(t *testing.T) {
+	g := newTwoTrianglesWithTailGraph()
+	tree := g.BlockCutTree()
+
+	tests := []struct {
+		u, v int
+		want bool
+	}{
+		{0, 1, true},  // Обе вершины в первом треугольнике
+		{2, 3, true},  // Обе вершины во втором треугольнике
+		{0, 3, false}, // Разные треугольники
+		{4, 5, true},  // Мост - тоже блок
+		{0, 5, false},
+	}
+	for _, tc := range tests {
+		if got := tree.IsSameBlock(tc.u, tc.v); got != tc.want {
+			t.Errorf("IsSameBlock(%d, %d) = %v, want %v", tc.u, tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestPathBlocks(t *testing.T) {
+	g := newTwoTrianglesWithTailGraph()
+	tree := g.BlockCutTree()
+
+	path := tree.PathBlocks(0, 5)
+	if len(path) != 3 {
+		t.Fatalf("PathBlocks(0, 5) = %v, want 3 blocks (two triangles + bridge)", path)
+	}
+
+	containsVertex := func(nodeIdx, v int) bool {
+		for _, edge := range tree.Nodes[nodeIdx].Edges {
+			if edge[0] == v || edge[1] == v {
+				return true
+			}
+		}
+		return false
+	}
+	if !containsVertex(path[0], 0) {
+		t.Errorf("первый блок пути не содержит вершину 0: %v", tree.Nodes[path[0]])
+	}
+	if !containsVertex(path[len(path)-1], 5) {
+		t.Errorf("последний блок пути не содержит вершину 5: %v", tree.Nodes[path[len(path)-1]])
+	}
+
+	if got := tree.PathBlocks(0, 0); got != nil {
+		t.Errorf("PathBlocks(0, 0) = %v, want nil", got)
+	}
+}
+
+func TestLCA(t *testing.T) {
+	// Дерево с корнем 0:
+	//        0
+	//      / | \
+	//     1  2  3
+	//    /|     |
+	//   4 5     6
+	//   |
+	//   7
+	g := NewGraph(8)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(0, 3)
+	g.AddEdge(1, 4)
+	g.AddEdge(1, 5)
+	g.AddEdge(3, 6)
+	g.AddEdge(4, 7)
+
+	lca := g.BuildLCA(0)
+
+	tests := []struct {
+		u, v, want int
+	}{
+		{4, 5, 1},
+		{7, 5, 1},
+		{4, 6, 0},
+		{2, 3, 0},
+		{7, 7, 7},
+		{1, 7, 1},
+	}
+	for _, tc := range tests {
+		if got := lca.Query(tc.u, tc.v); got != tc.want {
+			t.Errorf("Query(%d, %d) = %d, want %d", tc.u, tc.v, got, tc.want)
+		}
+	}
+
+	distTests := []struct {
+		u, v, want int
+	}{
+		{7, 5, 3}, // 7-4-1-5
+		{4, 6, 4}, // 4-1-0-3-6
+		{0, 7, 3}, // 0-1-4-7
+		{2, 2, 0},
+	}
+	for _, tc := range distTests {
+		if got := lca.Distance(tc.u, tc.v); got != tc.want {
+			t.Errorf("Distance(%d, %d) = %d, want %d", tc.u, tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	// Граф: цикл 0->1->2->0 (одна SCC), отдельная вершина 3, и цикл 4<->5
+	g := NewGraph(6)
+	g.IsDirected = true
+	g.AddDirectedEdge(0, 1)
+	g.AddDirectedEdge(1, 2)
+	g.AddDirectedEdge(2, 0)
+	g.AddDirectedEdge(2, 3)
+	g.AddDirectedEdge(3, 4)
+	g.AddDirectedEdge(4, 5)
+	g.AddDirectedEdge(5, 4)
+
+	got := sortedSCCs(g.StronglyConnectedComponents())
+	want := [][]int{{0, 1, 2}, {3}, {4, 5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("StronglyConnectedComponents() = %v, want %v", got, want)
+	}
+}
+
+func TestCondensationDAG(t *testing.T) {
+	g := NewGraph(6)
+	g.IsDirected = true
+	g.AddDirectedEdge(0, 1)
+	g.AddDirectedEdge(1, 2)
+	g.AddDirectedEdge(2, 0)
+	g.AddDirectedEdge(2, 3)
+	g.AddDirectedEdge(3, 4)
+	g.AddDirectedEdge(4, 5)
+	g.AddDirectedEdge(5, 4)
+
+	dag := g.CondensationDAG()
+	if dag.Vertices != 3 {
+		t.Fatalf("CondensationDAG() has %d vertices, want 3", dag.Vertices)
+	}
+	if !dag.IsDirected {
+		t.Fatalf("CondensationDAG() is not marked as directed")
+	}
+
+	totalEdges := 0
+	for _, adj := range dag.AdjList {
+		totalEdges += len(adj)
+	}
+	if totalEdges != 2 {
+		t.Fatalf("CondensationDAG() has %d edges, want 2", totalEdges)
+	}
+
+	// Конденсация не должна содержать циклов
+	visiting := make([]bool, dag.Vertices)
+	visited := make([]bool, dag.Vertices)
+	var hasCycle func(v int) bool
+	hasCycle = func(v int) bool {
+		visiting[v] = true
+		for _, w := range dag.AdjList[v] {
+			if visiting[w] {
+				return true
+			}
+			if !visited[w] && hasCycle(w) {
+				return true
+			}
+		}
+		visiting[v] = false
+		visited[v] = true
+		return false
+	}
+	for v := 0; v < dag.Vertices; v++ {
+		if !visited[v] && hasCycle(v) {
+			t.Fatalf("CondensationDAG() contains a cycle")
+		}
+	}
+}
+
+// newLayeredGraph строит синтетический слоистый граф: vertsPerLayer вершин на
+// каждый из layers слоёв, каждая вершина слоя i соединена с 3 вершинами
+// следующего слоя. Такая форма даёт длинные DFS-цепочки, на которых рекурсивная
+// реализация упёрлась бы в лимит глубины стека горутины
+func newLayeredGraph(layers, vertsPerLayer int) *Graph {
+	g := NewGraph(layers * vertsPerLayer)
+	for l := 0; l < layers-1; l++ {
+		for i := 0; i < vertsPerLayer; i++ {
+			u := l*vertsPerLayer + i
+			for k := 0; k < 3; k++ {
+				v := (l+1)*vertsPerLayer + (i+k)%vertsPerLayer
+				g.AddEdge(u, v)
+			}
+		}
+	}
+	return g
+}
+
+func BenchmarkArticulationPointsAndBridgesLayered(b *testing.B) {
+	g := newLayeredGraph(2000, 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.ArticulationPointsAndBridges()
+	}
+}
+
+func BenchmarkBiconnectedComponentsLayered(b *testing.B) {
+	g := newLayeredGraph(2000, 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.BiconnectedComponents()
+	}
+}
+
+func BenchmarkConnectedComponentsLayered(b *testing.B) {
+	g := newLayeredGraph(2000, 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.ConnectedComponents()
+	}
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Reader считывает граф из файла определённого формата
+type Reader interface {
+	Read(filename string) (*Graph, error)
+}
+
+// NewReader возвращает Reader для формата входного файла, заданного флагом -f.
+// Поддерживаются: bin (бинарная матрица смежности, формат по умолчанию),
+// dimacs, edgelist, adj, graphml
+func NewReader(format string) (Reader, error) {
+	switch format {
+	case "", "bin":
+		return BinaryMatrixReader{}, nil
+	case "dimacs":
+		return DIMACSReader{}, nil
+	case "edgelist":
+		return EdgeListReader{}, nil
+	case "adj":
+		return AdjacencyListReader{}, nil
+	case "graphml":
+		return GraphMLReader{}, nil
+	default:
+		return nil, fmt.Errorf("неизвестный формат входного файла: %s", format)
+	}
+}
+
+// BinaryMatrixReader считывает граф из бинарного файла в формате module'я по
+// умолчанию: int16 размер, затем матрица смежности размер x размер из int16
+type BinaryMatrixReader struct{}
+
+// Read считывает граф из бинарного файла
+// Формат файла: int16 размер, затем матрица смежности размер x размер, элементы типа int16
+func (BinaryMatrixReader) Read(filename string) (*Graph, error) {
+	return ReadGraph(filename)
+}
+
+// DIMACSReader считывает граф в формате DIMACS (строка "p edge n m",
+// затем m строк вида "e u v" с вершинами, нумеруемыми с единицы)
+type DIMACSReader struct{}
+
+func (DIMACSReader) Read(filename string) (*Graph, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при открытии входного файла: %v", err)
+	}
+	defer file.Close()
+
+	var graph *Graph
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "c") {
+			continue // Пустые строки и строки-комментарии пропускаем
+		}
+
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "p":
+			if len(fields) < 3 {
+				return nil, fmt.Errorf("некорректная строка заголовка DIMACS: %q", line)
+			}
+			n, err := strconv.Atoi(fields[2])
+			if err != nil {
+				return nil, fmt.Errorf("некорректное число вершин в заголовке DIMACS: %v", err)
+			}
+			graph = NewGraph(n)
+		case "e":
+			if graph == nil {
+				return nil, fmt.Errorf("ребро встретилось раньше строки заголовка 'p edge n m'")
+			}
+			if len(fields) < 3 {
+				return nil, fmt.Errorf("некорректная строка ребра DIMACS: %q", line)
+			}
+			u, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("некорректная вершина ребра DIMACS: %v", err)
+			}
+			v, err := strconv.Atoi(fields[2])
+			if err != nil {
+				return nil, fmt.Errorf("некорректная вершина ребра DIMACS: %v", err)
+			}
+			u, v = u-1, v-1 // DIMACS нумерует вершины с единицы
+			if u < 0 || u >= graph.Vertices || v < 0 || v >= graph.Vertices {
+				return nil, fmt.Errorf("вершина ребра DIMACS вне диапазона [1, %d]: %q", graph.Vertices, line)
+			}
+			graph.AddEdge(u, v)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при чтении файла DIMACS: %v", err)
+	}
+	if graph == nil {
+		return nil, fmt.Errorf("в файле DIMACS не найдена строка заголовка 'p edge n m'")
+	}
+
+	return graph, nil
+}
+
+// EdgeListReader считывает граф из списка рёбер: каждая строка - пара
+// разделённых пробелом вершин "u v". Необязательная первая строка с одним
+// числом трактуется как заголовок (количество вершин)
+type EdgeListReader struct{}
+
+func (EdgeListReader) Read(filename string) (*Graph, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при открытии входного файла: %v", err)
+	}
+	defer file.Close()
+
+	edges := [][2]int{}
+	maxVertex := -1
+	declaredSize := -1
+	firstLine := true
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+
+		if firstLine && len(fields) == 1 {
+			firstLine = false
+			if n, err := strconv.Atoi(fields[0]); err == nil {
+				declaredSize = n
+				continue // Строка-заголовок с количеством вершин, рёбер в ней нет
+			}
+		}
+		firstLine = false
+
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("некорректная строка списка рёбер: %q", line)
+		}
+		u, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("некорректная вершина в списке рёбер: %v", err)
+		}
+		v, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("некорректная вершина в списке рёбер: %v", err)
+		}
+		edges = append(edges, [2]int{u, v})
+		if u > maxVertex {
+			maxVertex = u
+		}
+		if v > maxVertex {
+			maxVertex = v
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при чтении файла списка рёбер: %v", err)
+	}
+
+	size := declaredSize
+	if size <= maxVertex {
+		size = maxVertex + 1
+	}
+	graph := NewGraph(size)
+	for _, e := range edges {
+		if e[0] < 0 || e[0] >= size || e[1] < 0 || e[1] >= size {
+			return nil, fmt.Errorf("вершина в списке рёбер вне диапазона [0, %d): (%d, %d)", size, e[0], e[1])
+		}
+		graph.AddEdge(e[0], e[1])
+	}
+
+	return graph, nil
+}
+
+// AdjacencyListReader считывает граф из списка смежности: i-я строка
+// перечисляет через пробел номера вершин, смежных с вершиной i
+type AdjacencyListReader struct{}
+
+func (AdjacencyListReader) Read(filename string) (*Graph, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при открытии входного файла: %v", err)
+	}
+	defer file.Close()
+
+	lines := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("ошибка при чтении файла списка смежности: %v", err)
+	}
+
+	graph := NewGraph(len(lines))
+	for u, line := range lines {
+		for _, field := range strings.Fields(line) {
+			v, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, fmt.Errorf("некорректная вершина в списке смежности на строке %d: %v", u, err)
+			}
+			if v < 0 || v >= len(lines) {
+				return nil, fmt.Errorf("вершина в списке смежности на строке %d вне диапазона [0, %d): %d", u, len(lines), v)
+			}
+			if v > u { // Избегаем дублирования неориентированного ребра
+				graph.AddEdge(u, v)
+			}
+		}
+	}
+
+	return graph, nil
+}
+
+// graphMLDocument и вложенные типы описывают минимальное подмножество
+// GraphML, достаточное для считывания вершин и рёбер графа
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLGraph struct {
+	Nodes []graphMLNode `xml:"node"`
+	Edges []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID string `xml:"id,attr"`
+}
+
+type graphMLEdge struct {
+	Source string `xml:"source,attr"`
+	Target string `xml:"target,attr"`
+}
+
+// GraphMLReader считывает граф из XML-файла в формате GraphML, используя
+// только идентификаторы узлов и рёбер (без атрибутов данных)
+type GraphMLReader struct{}
+
+func (GraphMLReader) Read(filename string) (*Graph, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при открытии входного файла: %v", err)
+	}
+
+	var doc graphMLDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("ошибка при разборе GraphML: %v", err)
+	}
+
+	// GraphML позволяет произвольные строковые идентификаторы узлов, поэтому
+	// сопоставляем их с плотными номерами вершин 0..n-1 в порядке объявления
+	index := make(map[string]int, len(doc.Graph.Nodes))
+	for _, node := range doc.Graph.Nodes {
+		if _, ok := index[node.ID]; !ok {
+			index[node.ID] = len(index)
+		}
+	}
+
+	graph := NewGraph(len(index))
+	for _, edge := range doc.Graph.Edges {
+		u, ok := index[edge.Source]
+		if !ok {
+			return nil, fmt.Errorf("ребро GraphML ссылается на неизвестный узел: %s", edge.Source)
+		}
+		v, ok := index[edge.Target]
+		if !ok {
+			return nil, fmt.Errorf("ребро GraphML ссылается на неизвестный узел: %s", edge.Target)
+		}
+		graph.AddEdge(u, v)
+	}
+
+	return graph, nil
+}
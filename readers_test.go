@@ -0,0 +1,130 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTempFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("не удалось создать временный файл: %v", err)
+	}
+	return path
+}
+
+func TestDIMACSReader(t *testing.T) {
+	path := writeTempFile(t, "g.dimacs", "c комментарий\np edge 4 3\ne 1 2\ne 2 3\ne 3 4\n")
+	g, err := DIMACSReader{}.Read(path)
+	if err != nil {
+		t.Fatalf("Read() вернул ошибку: %v", err)
+	}
+	if g.Vertices != 4 {
+		t.Fatalf("Vertices = %d, want 4", g.Vertices)
+	}
+	cc := g.ConnectedComponents()
+	if len(cc) != 1 || len(cc[0]) != 4 {
+		t.Fatalf("ConnectedComponents() = %v, want один компонент из 4 вершин", cc)
+	}
+}
+
+func TestDIMACSReaderOutOfRangeEdge(t *testing.T) {
+	path := writeTempFile(t, "g.dimacs", "p edge 3 1\ne 0 1\n")
+	if _, err := (DIMACSReader{}).Read(path); err == nil {
+		t.Fatal("Read() не вернул ошибку для ребра с вершиной вне диапазона")
+	}
+}
+
+func TestEdgeListReader(t *testing.T) {
+	path := writeTempFile(t, "g.edgelist", "4\n0 1\n1 2\n2 3\n")
+	g, err := EdgeListReader{}.Read(path)
+	if err != nil {
+		t.Fatalf("Read() вернул ошибку: %v", err)
+	}
+	if g.Vertices != 4 {
+		t.Fatalf("Vertices = %d, want 4", g.Vertices)
+	}
+	cc := g.ConnectedComponents()
+	if len(cc) != 1 || len(cc[0]) != 4 {
+		t.Fatalf("ConnectedComponents() = %v, want один компонент из 4 вершин", cc)
+	}
+}
+
+func TestEdgeListReaderOutOfRangeVertex(t *testing.T) {
+	path := writeTempFile(t, "g.edgelist", "-1 2\n")
+	if _, err := (EdgeListReader{}).Read(path); err == nil {
+		t.Fatal("Read() не вернул ошибку для отрицательной вершины")
+	}
+}
+
+func TestAdjacencyListReader(t *testing.T) {
+	path := writeTempFile(t, "g.adj", "1\n0 2\n1\n")
+	g, err := AdjacencyListReader{}.Read(path)
+	if err != nil {
+		t.Fatalf("Read() вернул ошибку: %v", err)
+	}
+	if g.Vertices != 3 {
+		t.Fatalf("Vertices = %d, want 3", g.Vertices)
+	}
+	cc := g.ConnectedComponents()
+	if len(cc) != 1 || len(cc[0]) != 3 {
+		t.Fatalf("ConnectedComponents() = %v, want один компонент из 3 вершин", cc)
+	}
+}
+
+func TestAdjacencyListReaderOutOfRangeVertex(t *testing.T) {
+	path := writeTempFile(t, "g.adj", "1\n5\n")
+	if _, err := (AdjacencyListReader{}).Read(path); err == nil {
+		t.Fatal("Read() не вернул ошибку для вершины вне диапазона")
+	}
+}
+
+func TestGraphMLReader(t *testing.T) {
+	xml := `<graphml><graph>` +
+		`<node id="a"/><node id="b"/><node id="c"/>` +
+		`<edge source="a" target="b"/><edge source="b" target="c"/>` +
+		`</graph></graphml>`
+	path := writeTempFile(t, "g.graphml", xml)
+	g, err := GraphMLReader{}.Read(path)
+	if err != nil {
+		t.Fatalf("Read() вернул ошибку: %v", err)
+	}
+	if g.Vertices != 3 {
+		t.Fatalf("Vertices = %d, want 3", g.Vertices)
+	}
+	cc := g.ConnectedComponents()
+	if len(cc) != 1 || len(cc[0]) != 3 {
+		t.Fatalf("ConnectedComponents() = %v, want один компонент из 3 вершин", cc)
+	}
+}
+
+func TestGraphMLReaderDuplicateNodeID(t *testing.T) {
+	xml := `<graphml><graph>` +
+		`<node id="a"/><node id="b"/><node id="a"/>` +
+		`<edge source="a" target="b"/>` +
+		`</graph></graphml>`
+	path := writeTempFile(t, "g.graphml", xml)
+	g, err := GraphMLReader{}.Read(path)
+	if err != nil {
+		t.Fatalf("Read() вернул ошибку: %v", err)
+	}
+	if g.Vertices != 2 {
+		t.Fatalf("Vertices = %d, want 2 (дубликат id не должен создавать лишнюю вершину)", g.Vertices)
+	}
+}
+
+func TestGraphMLReaderUnknownNode(t *testing.T) {
+	xml := `<graphml><graph><node id="a"/><edge source="a" target="missing"/></graph></graphml>`
+	path := writeTempFile(t, "g.graphml", xml)
+	if _, err := (GraphMLReader{}).Read(path); err == nil {
+		t.Fatal("Read() не вернул ошибку для ребра, ссылающегося на неизвестный узел")
+	}
+}
+
+func TestNewReaderUnknownFormat(t *testing.T) {
+	if _, err := NewReader("xyz"); err == nil {
+		t.Fatal("NewReader() не вернул ошибку для неизвестного формата")
+	}
+}
@@ -0,0 +1,327 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// AnalysisResult собирает все результаты анализа графа, которые выводятся
+// в выходной файл, независимо от того, в каком формате он записывается
+type AnalysisResult struct {
+	Graph              *Graph
+	ArticulationPoints []int
+	Bridges            [][2]int
+	BCC                [][][2]int
+	CC                 [][]int
+	SCC                [][]int
+	Forest             []Tree
+	BlockCutTree       *BlockCutTree
+}
+
+// Writer записывает результаты анализа графа в файл в определённом формате
+type Writer interface {
+	Write(filename string, result *AnalysisResult) error
+}
+
+// NewWriter возвращает Writer для формата выходного файла, заданного флагом -format.
+// Поддерживаются: text (текстовый отчёт module'я, формат по умолчанию), dot, json
+func NewWriter(format string) (Writer, error) {
+	switch format {
+	case "", "text":
+		return TextWriter{}, nil
+	case "dot":
+		return DotWriter{}, nil
+	case "json":
+		return JSONWriter{}, nil
+	default:
+		return nil, fmt.Errorf("неизвестный формат выходного файла: %s", format)
+	}
+}
+
+// TextWriter записывает результаты анализа в текстовом формате module'я по умолчанию
+type TextWriter struct{}
+
+func (TextWriter) Write(filename string, result *AnalysisResult) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("ошибка при создании выходного файла: %v", err)
+	}
+	defer f.Close()
+
+	// Алгоритмы связности (разделы a, b, c, e, f) по своей природе неориентированные,
+	// поэтому для ориентированного графа они выполняются над его неориентированной
+	// тенью (каждое ребро u->v без учёта направления) - предупреждаем об этом в отчёте
+	if result.Graph.IsDirected {
+		if _, err := f.WriteString("Примечание: граф ориентированный, разделы a, b, c, e, f вычислены по неориентированному варианту рёбер (без учёта направления).\n\n"); err != nil {
+			return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+		}
+	}
+
+	// Записываем раздел a) Мосты и точки сочленения
+	if _, err := f.WriteString("a) Мосты и точки сочленения:\n"); err != nil {
+		return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+	}
+
+	// Записываем точки сочленения
+	if _, err := f.WriteString("Точки сочленения:\n"); err != nil {
+		return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+	}
+	if len(result.ArticulationPoints) == 0 {
+		_, _ = f.WriteString("Отсутствуют\n")
+	} else {
+		for _, ap := range result.ArticulationPoints {
+			_, _ = f.WriteString(fmt.Sprintf("%d ", ap))
+		}
+		_, _ = f.WriteString("\n")
+	}
+
+	// Записываем мосты
+	if _, err := f.WriteString("Мосты:\n"); err != nil {
+		return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+	}
+	if len(result.Bridges) == 0 {
+		_, _ = f.WriteString("Отсутствуют\n")
+	} else {
+		for _, bridge := range result.Bridges {
+			_, _ = f.WriteString(fmt.Sprintf("(%d, %d) ", bridge[0], bridge[1]))
+		}
+		_, _ = f.WriteString("\n")
+	}
+
+	// Записываем раздел b) Компоненты двусвязности
+	if _, err := f.WriteString("\nb) Компоненты двусвязности:\n"); err != nil {
+		return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+	}
+	for i, component := range result.BCC {
+		if _, err := f.WriteString(fmt.Sprintf("Компонента %d:\n", i+1)); err != nil {
+			return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+		}
+		for _, edge := range component {
+			if _, err := f.WriteString(fmt.Sprintf("(%d, %d) ", edge[0], edge[1])); err != nil {
+				return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+			}
+		}
+		if _, err := f.WriteString("\n"); err != nil {
+			return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+		}
+	}
+
+	// Записываем раздел c) Компоненты связности
+	if _, err := f.WriteString("\nc) Компоненты связности:\n"); err != nil {
+		return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+	}
+	for i, component := range result.CC {
+		if _, err := f.WriteString(fmt.Sprintf("Компонента %d: ", i+1)); err != nil {
+			return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+		}
+		for _, vertex := range component {
+			if _, err := f.WriteString(fmt.Sprintf("%d ", vertex)); err != nil {
+				return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+			}
+		}
+		if _, err := f.WriteString("\n"); err != nil {
+			return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+		}
+	}
+
+	// Записываем раздел d) Компоненты сильной связности (только для ориентированных графов)
+	if result.Graph.IsDirected {
+		if _, err := f.WriteString("\nd) Компоненты сильной связности:\n"); err != nil {
+			return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+		}
+		for i, component := range result.SCC {
+			if _, err := f.WriteString(fmt.Sprintf("Компонента %d: ", i+1)); err != nil {
+				return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+			}
+			for _, vertex := range component {
+				if _, err := f.WriteString(fmt.Sprintf("%d ", vertex)); err != nil {
+					return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+				}
+			}
+			if _, err := f.WriteString("\n"); err != nil {
+				return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+			}
+		}
+	}
+
+	// Записываем раздел e) Остовный лес
+	if _, err := f.WriteString("\ne) Остовный лес:\n"); err != nil {
+		return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+	}
+	for i, tree := range result.Forest {
+		if _, err := f.WriteString(fmt.Sprintf("Компонента %d (корень %d):\n", i+1, tree.Root)); err != nil {
+			return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+		}
+
+		if _, err := f.WriteString("  Ветви: "); err != nil {
+			return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+		}
+		if len(tree.Edges) == 0 {
+			_, _ = f.WriteString("Отсутствуют")
+		}
+		for _, edge := range tree.Edges {
+			_, _ = f.WriteString(fmt.Sprintf("(%d, %d) ", edge[0], edge[1]))
+		}
+		_, _ = f.WriteString("\n")
+
+		if _, err := f.WriteString("  Хорды: "); err != nil {
+			return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+		}
+		if len(tree.Chords) == 0 {
+			_, _ = f.WriteString("Отсутствуют")
+		}
+		for _, chord := range tree.Chords {
+			_, _ = f.WriteString(fmt.Sprintf("(%d, %d) ", chord[0], chord[1]))
+		}
+		_, _ = f.WriteString("\n")
+	}
+
+	// Записываем раздел f) Блочно-срезанное дерево
+	if _, err := f.WriteString("\nf) Блочно-срезанное дерево:\n"); err != nil {
+		return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+	}
+	for i, node := range result.BlockCutTree.Nodes {
+		switch node.Kind {
+		case Cut:
+			if _, err := f.WriteString(fmt.Sprintf("Узел %d: точка сочленения %d\n", i, node.Vertex)); err != nil {
+				return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+			}
+		case Block:
+			if _, err := f.WriteString(fmt.Sprintf("Узел %d: блок ", i)); err != nil {
+				return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+			}
+			for _, edge := range node.Edges {
+				_, _ = f.WriteString(fmt.Sprintf("(%d, %d) ", edge[0], edge[1]))
+			}
+			_, _ = f.WriteString("\n")
+		}
+	}
+	for i, neighbors := range result.BlockCutTree.Adj {
+		if _, err := f.WriteString(fmt.Sprintf("Узел %d связан с: ", i)); err != nil {
+			return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+		}
+		for _, n := range neighbors {
+			_, _ = f.WriteString(fmt.Sprintf("%d ", n))
+		}
+		_, _ = f.WriteString("\n")
+	}
+
+	return nil
+}
+
+// DotWriter записывает граф в формате Graphviz DOT, выделяя мосты
+// пунктирной красной линией, а точки сочленения - жирным контуром узла
+type DotWriter struct{}
+
+func (DotWriter) Write(filename string, result *AnalysisResult) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("ошибка при создании выходного файла: %v", err)
+	}
+	defer f.Close()
+
+	isArticulation := make(map[int]bool, len(result.ArticulationPoints))
+	for _, ap := range result.ArticulationPoints {
+		isArticulation[ap] = true
+	}
+	isBridge := make(map[[2]int]bool, len(result.Bridges))
+	for _, bridge := range result.Bridges {
+		isBridge[bridge] = true
+		isBridge[[2]int{bridge[1], bridge[0]}] = true
+	}
+
+	graphType := "graph"
+	edgeOp := "--"
+	if result.Graph.IsDirected {
+		graphType = "digraph"
+		edgeOp = "->"
+	}
+
+	if _, err := fmt.Fprintf(f, "%s G {\n", graphType); err != nil {
+		return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+	}
+
+	for v := 0; v < result.Graph.Vertices; v++ {
+		style := ""
+		if isArticulation[v] {
+			style = " [style=bold]"
+		}
+		if _, err := fmt.Fprintf(f, "  %d%s;\n", v, style); err != nil {
+			return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+		}
+	}
+
+	written := make(map[[2]int]bool)
+	for u := 0; u < result.Graph.Vertices; u++ {
+		for _, v := range result.Graph.AdjList[u] {
+			if !result.Graph.IsDirected {
+				key := [2]int{u, v}
+				if u > v {
+					key = [2]int{v, u}
+				}
+				if written[key] {
+					continue // Неориентированное ребро хранится дважды, выводим один раз
+				}
+				written[key] = true
+			}
+
+			style := ""
+			if isBridge[[2]int{u, v}] {
+				style = " [style=dashed, color=red]"
+			}
+			if _, err := fmt.Fprintf(f, "  %d %s %d%s;\n", u, edgeOp, v, style); err != nil {
+				return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+			}
+		}
+	}
+
+	if _, err := f.WriteString("}\n"); err != nil {
+		return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+	}
+
+	return nil
+}
+
+// jsonAnalysisResult - сериализуемое в JSON представление AnalysisResult
+type jsonAnalysisResult struct {
+	Vertices           int           `json:"vertices"`
+	IsDirected         bool          `json:"is_directed"`
+	ArticulationPoints []int         `json:"articulation_points"`
+	Bridges            [][2]int      `json:"bridges"`
+	BCC                [][][2]int    `json:"biconnected_components"`
+	CC                 [][]int       `json:"connected_components"`
+	SCC                [][]int       `json:"strongly_connected_components,omitempty"`
+	Forest             []Tree        `json:"spanning_forest"`
+	BlockCutTree       *BlockCutTree `json:"block_cut_tree"`
+}
+
+// JSONWriter записывает результаты анализа в формате JSON для программной обработки
+type JSONWriter struct{}
+
+func (JSONWriter) Write(filename string, result *AnalysisResult) error {
+	payload := jsonAnalysisResult{
+		Vertices:           result.Graph.Vertices,
+		IsDirected:         result.Graph.IsDirected,
+		ArticulationPoints: result.ArticulationPoints,
+		Bridges:            result.Bridges,
+		BCC:                result.BCC,
+		CC:                 result.CC,
+		Forest:             result.Forest,
+		BlockCutTree:       result.BlockCutTree,
+	}
+	if result.Graph.IsDirected {
+		payload.SCC = result.SCC
+	}
+
+	data, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return fmt.Errorf("ошибка при сериализации результатов в JSON: %v", err)
+	}
+
+	if err := os.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("ошибка при записи в выходной файл: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newAnalysisResultForWriterTests строит небольшой граф-"мостик" 0-1-2 (ребро
+// (1,2) - мост, 1 - точка сочленения) и полный набор результатов анализа для него
+func newAnalysisResultForWriterTests() *AnalysisResult {
+	g := NewGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+
+	articulationPoints, bridges := g.ArticulationPointsAndBridges()
+	return &AnalysisResult{
+		Graph:              g,
+		ArticulationPoints: articulationPoints,
+		Bridges:            bridges,
+		BCC:                g.BiconnectedComponents(),
+		CC:                 g.ConnectedComponents(),
+		SCC:                g.StronglyConnectedComponents(),
+		Forest:             g.SpanningForest(),
+		BlockCutTree:       g.BlockCutTree(),
+	}
+}
+
+func TestTextWriter(t *testing.T) {
+	result := newAnalysisResultForWriterTests()
+	path := filepath.Join(t.TempDir(), "out.txt")
+	if err := (TextWriter{}).Write(path, result); err != nil {
+		t.Fatalf("Write() вернул ошибку: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("не удалось прочитать выходной файл: %v", err)
+	}
+	out := string(data)
+	for _, want := range []string{"a) Мосты и точки сочленения", "b) Компоненты двусвязности", "c) Компоненты связности", "e) Остовный лес", "f) Блочно-срезанное дерево"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("вывод не содержит раздел %q", want)
+		}
+	}
+	if strings.Contains(out, "d) Компоненты сильной связности") {
+		t.Error("вывод содержит раздел d) для неориентированного графа")
+	}
+}
+
+func TestDotWriter(t *testing.T) {
+	result := newAnalysisResultForWriterTests()
+	path := filepath.Join(t.TempDir(), "out.dot")
+	if err := (DotWriter{}).Write(path, result); err != nil {
+		t.Fatalf("Write() вернул ошибку: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("не удалось прочитать выходной файл: %v", err)
+	}
+	out := string(data)
+	if !strings.HasPrefix(out, "graph G {") {
+		t.Errorf("вывод не начинается с объявления неориентированного графа: %q", out)
+	}
+	if !strings.Contains(out, "1 [style=bold]") {
+		t.Errorf("точка сочленения 1 не выделена жирным: %q", out)
+	}
+	if !strings.Contains(out, "style=dashed, color=red") {
+		t.Errorf("мост не выделен пунктиром: %q", out)
+	}
+}
+
+func TestJSONWriter(t *testing.T) {
+	result := newAnalysisResultForWriterTests()
+	path := filepath.Join(t.TempDir(), "out.json")
+	if err := (JSONWriter{}).Write(path, result); err != nil {
+		t.Fatalf("Write() вернул ошибку: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("не удалось прочитать выходной файл: %v", err)
+	}
+	var payload jsonAnalysisResult
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatalf("не удалось разобрать JSON: %v", err)
+	}
+	if payload.Vertices != 3 {
+		t.Errorf("vertices = %d, want 3", payload.Vertices)
+	}
+	if payload.IsDirected {
+		t.Error("is_directed = true для неориентированного графа")
+	}
+	if len(payload.SCC) != 0 {
+		t.Errorf("strongly_connected_components не должны присутствовать для неориентированного графа, получено %v", payload.SCC)
+	}
+	if payload.BlockCutTree == nil || len(payload.BlockCutTree.Nodes) == 0 {
+		t.Error("block_cut_tree не заполнено")
+	}
+}
+
+func TestNewWriterUnknownFormat(t *testing.T) {
+	if _, err := NewWriter("xyz"); err == nil {
+		t.Fatal("NewWriter() не вернул ошибку для неизвестного формата")
+	}
+}